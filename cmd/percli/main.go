@@ -17,39 +17,58 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/perses/perses/internal/cli/cmd/apply"
+	"github.com/perses/perses/internal/cli/cmd/create"
+	"github.com/perses/perses/internal/cli/cmd/datasource"
+	"github.com/perses/perses/internal/cli/cmd/delete"
+	"github.com/perses/perses/internal/cli/cmd/dump"
 	"github.com/perses/perses/internal/cli/cmd/login"
 	"github.com/perses/perses/internal/cli/cmd/project"
+	"github.com/perses/perses/internal/cli/cmd/restore"
 	"github.com/perses/perses/internal/cli/cmd/version"
-	"github.com/sirupsen/logrus"
+	cmdUtils "github.com/perses/perses/internal/cli/utils"
+	"github.com/perses/perses/pkg/logger"
+	// Register the built-in datasource plugins.
+	_ "github.com/perses/perses/pkg/datasource/prometheus"
 	"github.com/spf13/cobra"
 )
 
+var (
+	logFormat string
+	logLevel  string
+)
+
 func newRootCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "percli",
 		Short: "Command line interface to interact with the Perses API",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := logger.Init(logFormat, logLevel); err != nil {
+				return err
+			}
+			cmdUtils.InitGlobalConfig(cmdUtils.GetDefaultConfigPath())
+			return nil
+		},
 	}
+	cmd.PersistentFlags().StringVar(&logFormat, "log-format", logger.FormatText, "Must be \"text\" or \"json\". Controls how log lines are rendered.")
+	cmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "Must be \"debug\", \"info\", \"warn\" or \"error\".")
 
 	cmd.AddCommand(version.NewCMD())
 	cmd.AddCommand(login.NewCMD())
 	cmd.AddCommand(project.NewCMD())
+	cmd.AddCommand(create.NewCMD())
+	cmd.AddCommand(apply.NewCMD())
+	cmd.AddCommand(delete.NewCMD())
+	cmd.AddCommand(dump.NewCMD())
+	cmd.AddCommand(restore.NewCMD())
+	cmd.AddCommand(datasource.NewCMD())
 	return cmd
 }
 
-func initLogrus() {
-	logrus.SetFormatter(&logrus.TextFormatter{
-		// Useful when you have a TTY attached.
-		// Issue explained here when this field is set to false by default:
-		// https://github.com/sirupsen/logrus/issues/896
-		FullTimestamp: true,
-	})
-}
-
 func main() {
-	initLogrus()
 	rootCmd := newRootCommand()
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-}
\ No newline at end of file
+}
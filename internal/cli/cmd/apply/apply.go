@@ -0,0 +1,139 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	cmdUtils "github.com/perses/perses/internal/cli/utils"
+	"github.com/perses/perses/internal/cli/utils/file"
+	cmdUtilsService "github.com/perses/perses/internal/cli/utils/service"
+	"github.com/perses/perses/pkg/client/api"
+	"github.com/perses/perses/pkg/client/perseshttp"
+	modelV1 "github.com/perses/perses/pkg/model/api/v1"
+	"github.com/spf13/cobra"
+)
+
+type option struct {
+	cmdUtils.CMDOption
+	writer    io.Writer
+	file      string
+	project   string
+	dryRun    string
+	apiClient api.ClientInterface
+}
+
+func (o *option) Complete(args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("no args are supported by the command 'apply'")
+	}
+	if len(o.project) == 0 {
+		o.project = cmdUtils.GlobalConfig.Project
+	}
+	apiClient, err := cmdUtils.GlobalConfig.GetAPIClient()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+func (o *option) Validate() error {
+	if len(o.file) == 0 {
+		return fmt.Errorf("file must be provided")
+	}
+	return cmdUtils.ValidateDryRun(o.dryRun)
+}
+
+func (o *option) Execute() error {
+	unmarshaller := file.Unmarshaller{}
+	entities, err := unmarshaller.Unmarshal(o.file)
+	if err != nil {
+		return err
+	}
+	for _, entity := range entities {
+		kind := modelV1.Kind(entity.GetKind())
+		name := entity.GetMetadata().GetName()
+		project := cmdUtils.GetProject(entity.GetMetadata(), o.project)
+		svc, svcErr := cmdUtilsService.NewService(kind, project, o.apiClient)
+		if svcErr != nil {
+			return svcErr
+		}
+
+		// Diff against the current server state to decide whether this is a create or an update.
+		_, apiError := svc.GetResource(name)
+		if apiError != nil && !errors.Is(apiError, perseshttp.RequestNotFoundError) {
+			return fmt.Errorf("unable to retrieve the %q %q from the Perses API. %w", kind, name, apiError)
+		}
+		exists := apiError == nil
+
+		if o.dryRun == cmdUtils.DryRunClient {
+			verb := "created"
+			if exists {
+				verb = "updated"
+			}
+			if outputErr := cmdUtils.HandleSuccessResourceMessage(o.writer, kind, project, fmt.Sprintf("object %q %q would be %s (client dry-run)", kind, name, verb)); outputErr != nil {
+				return outputErr
+			}
+			continue
+		}
+
+		serverDryRun := o.dryRun == cmdUtils.DryRunServer
+		if exists {
+			if _, updateErr := svc.UpdateResource(entity, serverDryRun); updateErr != nil {
+				return updateErr
+			}
+		} else {
+			if _, createErr := svc.CreateResource(entity, serverDryRun); createErr != nil {
+				return createErr
+			}
+		}
+
+		if outputErr := cmdUtils.HandleSuccessResourceMessage(o.writer, kind, project, fmt.Sprintf("object %q %q has been applied", kind, name)); outputErr != nil {
+			return outputErr
+		}
+	}
+	return nil
+}
+
+func (o *option) SetWriter(writer io.Writer) {
+	o.writer = writer
+}
+
+func NewCMD() *cobra.Command {
+	o := &option{}
+	cmd := &cobra.Command{
+		Use:   "apply -f [FILENAME]",
+		Short: "Create or update resources through a file. JSON or YAML format supported",
+		Example: `
+# Create/update the resources from the file resources.json to the remote Perses server.
+percli apply -f ./resources.json
+
+# Apply the JSON passed into stdin to the remote Perses server.
+cat ./resources.json | percli apply -f -
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdUtils.RunCMD(o, cmd, args)
+		},
+	}
+	cmd.Flags().StringVarP(&o.project, "project", "p", o.project, "If present, the project scope for this CLI request.")
+	cmd.Flags().StringVarP(&o.file, "file", "f", o.file, "Path to the file that contains the resources to create/update.")
+	cmd.Flags().StringVar(&o.dryRun, "dry-run", cmdUtils.DryRunNone, "Must be \"none\", \"client\" or \"server\". If client, only print what would be applied. If server, submit the request without persisting it.")
+	if err := cmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"fmt"
+	"io"
+
+	cmdUtils "github.com/perses/perses/internal/cli/utils"
+	"github.com/perses/perses/pkg/client/api"
+	dtsPlugin "github.com/perses/perses/pkg/datasource"
+	"github.com/spf13/cobra"
+)
+
+type checkOption struct {
+	cmdUtils.CMDOption
+	writer    io.Writer
+	name      string
+	project   string
+	apiClient api.ClientInterface
+}
+
+func (o *checkOption) Complete(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("you have to specify the name of the datasource to check")
+	}
+	o.name = args[0]
+	if len(o.project) == 0 {
+		o.project = cmdUtils.GlobalConfig.Project
+	}
+	apiClient, err := cmdUtils.GlobalConfig.GetAPIClient()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+func (o *checkOption) Validate() error {
+	return nil
+}
+
+// Execute runs the health check from the CLI process itself, fetching the datasource's spec from
+// the API and probing it directly rather than asking the server to do so on its behalf -- this
+// snapshot has no server-side endpoint to trigger an on-demand check. As a result, `check` requires
+// the machine running percli to have the same network reachability to the datasource backend as the
+// Perses server does; a CI runner without that reachability will see false failures.
+func (o *checkOption) Execute() error {
+	entity, err := o.apiClient.V1().Datasource(o.project).Get(o.name)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the datasource %q: %w", o.name, err)
+	}
+
+	result, checkErr := dtsPlugin.HealthCheck(entity.Spec.GetKind(), entity.Spec.Config)
+	if checkErr != nil {
+		return checkErr
+	}
+
+	if outputErr := cmdUtils.HandleString(o.writer, fmt.Sprintf("%s: %s (latency %s)", o.name, result.Message, result.Latency)); outputErr != nil {
+		return outputErr
+	}
+	if !result.Healthy {
+		return fmt.Errorf("datasource %q is unhealthy", o.name)
+	}
+	return nil
+}
+
+func (o *checkOption) SetWriter(writer io.Writer) {
+	o.writer = writer
+}
+
+func newCheckCMD() *cobra.Command {
+	o := &checkOption{}
+	cmd := &cobra.Command{
+		Use:   "check NAME",
+		Short: "Trigger an on-demand health check of a datasource",
+		Example: `
+# Check the datasource "my_datasource", useful as a CI gate in a dashboard-provisioning pipeline.
+# Requires the machine running percli to be able to reach the datasource backend directly.
+percli datasource check my_datasource
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdUtils.RunCMD(o, cmd, args)
+		},
+	}
+	cmd.Flags().StringVarP(&o.project, "project", "p", o.project, "If present, the project scope for this CLI request.")
+	return cmd
+}
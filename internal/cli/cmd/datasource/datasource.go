@@ -0,0 +1,27 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datasource groups the subcommands specific to the datasource resource, as opposed to the
+// generic create/apply/delete/dump/restore commands shared by every resource kind.
+package datasource
+
+import "github.com/spf13/cobra"
+
+func NewCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "datasource",
+		Short: "Subcommands specific to the datasource resource",
+	}
+	cmd.AddCommand(newCheckCMD())
+	return cmd
+}
@@ -0,0 +1,158 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delete
+
+import (
+	"fmt"
+	"io"
+
+	cmdUtils "github.com/perses/perses/internal/cli/utils"
+	"github.com/perses/perses/internal/cli/utils/file"
+	cmdUtilsService "github.com/perses/perses/internal/cli/utils/service"
+	"github.com/perses/perses/pkg/client/api"
+	modelAPI "github.com/perses/perses/pkg/model/api"
+	modelV1 "github.com/perses/perses/pkg/model/api/v1"
+	"github.com/spf13/cobra"
+)
+
+// keyCombination is a combination of the project name and the name of the resource.
+// These two pieces of information are used to delete an unique resource.
+type keyCombination struct {
+	project string
+	name    string
+}
+
+type option struct {
+	cmdUtils.CMDOption
+	writer    io.Writer
+	kind      modelV1.Kind
+	file      string
+	project   string
+	names     map[modelV1.Kind][]keyCombination
+	apiClient api.ClientInterface
+}
+
+func (o *option) Complete(args []string) error {
+	o.names = make(map[modelV1.Kind][]keyCombination)
+	if len(o.project) == 0 {
+		o.project = cmdUtils.GlobalConfig.Project
+	}
+	if len(o.file) == 0 {
+		if len(args) == 0 {
+			return fmt.Errorf(cmdUtils.FormatAvailableResourcesMessage())
+		}
+
+		var err error
+		o.kind, err = cmdUtils.GetKind(args[0])
+		if err != nil {
+			return err
+		}
+
+		if len(args) <= 1 {
+			return fmt.Errorf("you have to specify the resource name you would like to delete")
+		}
+
+		for _, name := range args[1:] {
+			o.names[o.kind] = append(o.names[o.kind], keyCombination{
+				name:    name,
+				project: o.project,
+			})
+		}
+	}
+	var err error
+	o.apiClient, err = cmdUtils.GlobalConfig.GetAPIClient()
+	return err
+}
+
+func (o *option) Validate() error {
+	return nil
+}
+
+func (o *option) Execute() error {
+	if len(o.file) > 0 {
+		if err := o.setNamesFromFile(); err != nil {
+			return err
+		}
+	}
+	for kind, keys := range o.names {
+		for _, key := range keys {
+			svc, svcErr := cmdUtilsService.NewService(kind, key.project, o.apiClient)
+			if svcErr != nil {
+				return svcErr
+			}
+			if err := svc.DeleteResource(key.name); err != nil {
+				return err
+			}
+			if outputErr := cmdUtils.HandleSuccessResourceMessage(o.writer, kind, key.project, fmt.Sprintf("object %q %q has been deleted", kind, key.name)); outputErr != nil {
+				return outputErr
+			}
+		}
+	}
+	return nil
+}
+
+func (o *option) setNamesFromFile() error {
+	unmarshaller := file.Unmarshaller{}
+	entities, err := unmarshaller.Unmarshal(o.file)
+	if err != nil {
+		return err
+	}
+	o.setNames(entities)
+	return nil
+}
+
+func (o *option) setNames(entities []modelAPI.Entity) {
+	for _, entity := range entities {
+		kind := modelV1.Kind(entity.GetKind())
+		metadata := entity.GetMetadata()
+		o.names[kind] = append(o.names[kind], keyCombination{
+			name:    metadata.GetName(),
+			project: cmdUtils.GetProject(metadata, o.project),
+		})
+	}
+}
+
+func (o *option) SetWriter(writer io.Writer) {
+	o.writer = writer
+}
+
+func NewCMD() *cobra.Command {
+	o := &option{}
+	cmd := &cobra.Command{
+		Use:   "delete (-f [FILENAME] | TYPE [NAME1 NAME2...])",
+		Short: "Delete resources",
+		Long: `
+JSON and YAML formats are accepted.
+
+If both a filename and command line arguments are passed, the filename is used and the command line arguments are
+ignored.
+`,
+		Example: `
+# Delete any kind of resources from a file
+percli delete -f data.json
+
+# Delete any kind of resources from stdin
+cat data.json | percli delete -f -
+
+# Delete a specific datasource
+percli delete datasources my_datasource
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdUtils.RunCMD(o, cmd, args)
+		},
+	}
+	cmd.Flags().StringVarP(&o.file, "file", "f", o.file, "Path to the file that contains the resources to delete")
+	cmd.Flags().StringVarP(&o.project, "project", "p", o.project, "If present, the project scope for this CLI request")
+	return cmd
+}
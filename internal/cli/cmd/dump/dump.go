@@ -0,0 +1,199 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	cmdUtils "github.com/perses/perses/internal/cli/utils"
+	cmdUtilsService "github.com/perses/perses/internal/cli/utils/service"
+	"github.com/perses/perses/pkg/client/api"
+	modelAPI "github.com/perses/perses/pkg/model/api"
+	modelV1 "github.com/perses/perses/pkg/model/api/v1"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// archiveVersion is written to version.json so that `restore` can refuse archives it doesn't know
+// how to read.
+const archiveVersion = "1"
+
+type versionManifest struct {
+	Version string `json:"version" yaml:"version"`
+}
+
+// config is written to config.yaml to record under what conditions the archive was produced. The
+// `restore` command does not read it.
+type config struct {
+	Project   string    `yaml:"project,omitempty"`
+	CreatedAt time.Time `yaml:"created_at"`
+}
+
+type option struct {
+	cmdUtils.CMDOption
+	writer    io.Writer
+	output    string
+	project   string
+	apiClient api.ClientInterface
+}
+
+func (o *option) Complete(args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("no args are supported by the command 'dump'")
+	}
+	apiClient, err := cmdUtils.GlobalConfig.GetAPIClient()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+func (o *option) Validate() error {
+	if len(o.output) == 0 {
+		return fmt.Errorf("output must be provided")
+	}
+	return nil
+}
+
+func (o *option) Execute() error {
+	out, closeFunc, err := o.openOutput()
+	if err != nil {
+		return err
+	}
+	defer closeFunc() // nolint: errcheck
+
+	archive := zip.NewWriter(out)
+	if manifestErr := writeManifest(archive, o.project); manifestErr != nil {
+		return manifestErr
+	}
+
+	for _, kind := range cmdUtils.AllKinds() {
+		project := o.project
+		if cmdUtils.IsGlobalResource(kind) {
+			project = ""
+		}
+		svc, svcErr := cmdUtilsService.NewService(kind, project, o.apiClient)
+		if svcErr != nil {
+			return svcErr
+		}
+
+		var entities []modelAPI.Entity
+		var dumpErr error
+		if cmdUtils.IsGlobalResource(kind) && len(o.project) > 0 {
+			// --project restricts a global resource like Project to the single project named, rather
+			// than every project in the instance.
+			var entity modelAPI.Entity
+			entity, dumpErr = svc.GetResource(o.project)
+			if dumpErr == nil {
+				entities = []modelAPI.Entity{entity}
+			}
+		} else {
+			entities, dumpErr = svc.DumpAll()
+		}
+		if dumpErr != nil {
+			return fmt.Errorf("unable to dump the %ss: %w", kind, dumpErr)
+		}
+		for _, entity := range entities {
+			if writeErr := writeEntity(archive, kind, entity); writeErr != nil {
+				return writeErr
+			}
+		}
+	}
+
+	if closeErr := archive.Close(); closeErr != nil {
+		return closeErr
+	}
+	if o.output == "-" {
+		// The archive itself went to stdout: a status line there would be appended to it.
+		return nil
+	}
+	return cmdUtils.HandleString(o.writer, fmt.Sprintf("instance dumped to %q", o.output))
+}
+
+func (o *option) openOutput() (io.Writer, func() error, error) {
+	if o.output == "-" {
+		return os.Stdout, func() error { return nil }, nil
+	}
+	file, err := os.Create(o.output) // nolint: gosec
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create the output file %q: %w", o.output, err)
+	}
+	return file, file.Close, nil
+}
+
+func writeManifest(archive *zip.Writer, project string) error {
+	if err := writeFile(archive, "version.json", versionManifest{Version: archiveVersion}); err != nil {
+		return err
+	}
+	return writeFile(archive, "config.yaml", config{Project: project, CreatedAt: time.Now().UTC()})
+}
+
+func writeEntity(archive *zip.Writer, kind modelV1.Kind, entity modelAPI.Entity) error {
+	name := fmt.Sprintf("%s.yaml", entity.GetMetadata().GetName())
+	if !cmdUtils.IsGlobalResource(kind) {
+		name = fmt.Sprintf("%s/%s", cmdUtils.GetProject(entity.GetMetadata(), ""), name)
+	}
+	return writeFile(archive, fmt.Sprintf("%s/%s", pluralKind(kind), name), entity)
+}
+
+func writeFile(archive *zip.Writer, name string, obj interface{}) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("unable to marshal %q: %w", name, err)
+	}
+	entryWriter, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("unable to create the archive entry %q: %w", name, err)
+	}
+	_, err = entryWriter.Write(data)
+	return err
+}
+
+func pluralKind(kind modelV1.Kind) string {
+	return strings.ToLower(string(kind)) + "s"
+}
+
+func (o *option) SetWriter(writer io.Writer) {
+	o.writer = writer
+}
+
+func NewCMD() *cobra.Command {
+	o := &option{}
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Dump every resource of a Perses instance into a single archive",
+		Example: `
+# Dump the whole instance to a file.
+percli dump --output backup.zip
+
+# Dump only the resources of a project to stdout.
+percli dump --project my_project --output - > backup.zip
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdUtils.RunCMD(o, cmd, args)
+		},
+	}
+	cmd.Flags().StringVarP(&o.project, "project", "p", o.project, "If present, restrict the dump to the resources of this project.")
+	cmd.Flags().StringVarP(&o.output, "output", "o", o.output, "Path of the archive to write to. Use \"-\" to write to stdout.")
+	if err := cmd.MarkFlagRequired("output"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
@@ -0,0 +1,239 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	cmdUtils "github.com/perses/perses/internal/cli/utils"
+	cmdUtilsService "github.com/perses/perses/internal/cli/utils/service"
+	"github.com/perses/perses/pkg/client/api"
+	modelAPI "github.com/perses/perses/pkg/model/api"
+	modelV1 "github.com/perses/perses/pkg/model/api/v1"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// archiveVersion is the only version of the archive this command knows how to restore, see the
+// `dump` command.
+const archiveVersion = "1"
+
+// kindOrder is the order the resource kinds must be restored in: a Project has to exist before the
+// Dashboards/Datasources it contains can be created.
+var kindOrder = []modelV1.Kind{modelV1.KindProject, modelV1.KindDashboard, modelV1.KindDatasource}
+
+type versionManifest struct {
+	Version string `json:"version" yaml:"version"`
+}
+
+type option struct {
+	cmdUtils.CMDOption
+	writer     io.Writer
+	file       string
+	onConflict string
+	apiClient  api.ClientInterface
+	archive    *zip.Reader
+}
+
+func (o *option) Complete(args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("no args are supported by the command 'restore'")
+	}
+	apiClient, err := cmdUtils.GlobalConfig.GetAPIClient()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+func (o *option) Validate() error {
+	if len(o.file) == 0 {
+		return fmt.Errorf("file must be provided")
+	}
+	if err := cmdUtils.ValidateOnConflict(o.onConflict); err != nil {
+		return err
+	}
+
+	archive, err := openArchive(o.file)
+	if err != nil {
+		return err
+	}
+	o.archive = archive
+	return validateVersion(archive)
+}
+
+func (o *option) Execute() error {
+	entitiesByKind, err := readEntities(o.archive)
+	if err != nil {
+		return err
+	}
+
+	for _, kind := range kindOrder {
+		entities := entitiesByKind[kind]
+		if len(entities) == 0 {
+			continue
+		}
+		// Entities of a project-scoped kind can come from several projects; restore them project by
+		// project so that each Service is correctly bound to it.
+		byProject := groupByProject(kind, entities)
+		for project, projectEntities := range byProject {
+			svc, svcErr := cmdUtilsService.NewService(kind, project, o.apiClient)
+			if svcErr != nil {
+				return svcErr
+			}
+			restored, restoreErr := svc.RestoreAll(projectEntities, o.onConflict)
+			if restoreErr != nil {
+				return restoreErr
+			}
+			for _, entity := range restored {
+				if outputErr := cmdUtils.HandleSuccessResourceMessage(o.writer, kind, project, fmt.Sprintf("object %q %q has been restored", kind, entity.GetMetadata().GetName())); outputErr != nil {
+					return outputErr
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func openArchive(file string) (*zip.Reader, error) {
+	if file == "-" {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the archive from stdin: %w", err)
+		}
+		return zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	}
+	reader, err := zip.OpenReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the archive %q: %w", file, err)
+	}
+	return &reader.Reader, nil
+}
+
+func validateVersion(archive *zip.Reader) error {
+	data, err := readFile(archive, "version.json")
+	if err != nil {
+		return fmt.Errorf("%q doesn't look like a dump archive: %w", "version.json", err)
+	}
+	manifest := &versionManifest{}
+	if unmarshalErr := yaml.Unmarshal(data, manifest); unmarshalErr != nil {
+		return fmt.Errorf("unable to read %q: %w", "version.json", unmarshalErr)
+	}
+	if manifest.Version != archiveVersion {
+		return fmt.Errorf("archive version %q is not supported, expected %q", manifest.Version, archiveVersion)
+	}
+	return nil
+}
+
+// readEntities unmarshals every resource file of the archive, grouped by kind.
+func readEntities(archive *zip.Reader) (map[modelV1.Kind][]modelAPI.Entity, error) {
+	result := make(map[modelV1.Kind][]modelAPI.Entity)
+	for _, file := range archive.File {
+		kind, ok := entryKind(file.Name)
+		if !ok {
+			continue
+		}
+		entity, err := modelV1.GetStruct(kind)
+		if err != nil {
+			return nil, fmt.Errorf("resource %q not supported by the command", kind)
+		}
+		data, readErr := readFile(archive, file.Name)
+		if readErr != nil {
+			return nil, readErr
+		}
+		if unmarshalErr := yaml.Unmarshal(data, entity); unmarshalErr != nil {
+			return nil, fmt.Errorf("unable to unmarshal %q: %w", file.Name, unmarshalErr)
+		}
+		result[kind] = append(result[kind], entity)
+	}
+	return result, nil
+}
+
+// entryKind returns the resource kind the archive entry at name belongs to, e.g. "dashboards/my_project/foo.yaml"
+// belongs to KindDashboard. Non-resource entries (version.json, config.yaml) are reported as not ok.
+func entryKind(name string) (modelV1.Kind, bool) {
+	plural := strings.SplitN(name, "/", 2)[0]
+	for _, kind := range kindOrder {
+		if plural == pluralKind(kind) {
+			return kind, true
+		}
+	}
+	return "", false
+}
+
+func groupByProject(kind modelV1.Kind, entities []modelAPI.Entity) map[string][]modelAPI.Entity {
+	if cmdUtils.IsGlobalResource(kind) {
+		return map[string][]modelAPI.Entity{"": entities}
+	}
+	result := make(map[string][]modelAPI.Entity)
+	for _, entity := range entities {
+		project := cmdUtils.GetProject(entity.GetMetadata(), "")
+		result[project] = append(result[project], entity)
+	}
+	return result
+}
+
+func readFile(archive *zip.Reader, name string) ([]byte, error) {
+	for _, file := range archive.File {
+		if file.Name != name {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close() // nolint: errcheck
+		return ioutil.ReadAll(reader)
+	}
+	return nil, fmt.Errorf("%q not found in the archive", name)
+}
+
+func pluralKind(kind modelV1.Kind) string {
+	return strings.ToLower(string(kind)) + "s"
+}
+
+func (o *option) SetWriter(writer io.Writer) {
+	o.writer = writer
+}
+
+func NewCMD() *cobra.Command {
+	o := &option{}
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore every resource of a Perses instance from an archive produced by `dump`",
+		Example: `
+# Restore an instance from a file, skipping resources that already exist.
+percli restore -f backup.zip --on-conflict=skip
+
+# Restore an instance from stdin, overwriting resources that already exist.
+cat backup.zip | percli restore -f - --on-conflict=overwrite
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmdUtils.RunCMD(o, cmd, args)
+		},
+	}
+	cmd.Flags().StringVarP(&o.file, "file", "f", o.file, "Path of the archive produced by `dump`. Use \"-\" to read from stdin.")
+	cmd.Flags().StringVar(&o.onConflict, "on-conflict", cmdUtils.OnConflictFail, "Must be \"skip\", \"overwrite\" or \"fail\". Decides what to do when a resource from the archive already exists.")
+	if err := cmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
@@ -0,0 +1,32 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "fmt"
+
+const (
+	DryRunNone   = "none"
+	DryRunClient = "client"
+	DryRunServer = "server"
+)
+
+// ValidateDryRun ensures the value passed to --dry-run is one of the supported modes.
+func ValidateDryRun(dryRun string) error {
+	switch dryRun {
+	case DryRunNone, DryRunClient, DryRunServer:
+		return nil
+	default:
+		return fmt.Errorf("--dry-run must be %q, %q or %q", DryRunNone, DryRunClient, DryRunServer)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/perses/perses/pkg/logger"
+	modelAPI "github.com/perses/perses/pkg/model/api"
+	modelV1 "github.com/perses/perses/pkg/model/api/v1"
+	"gopkg.in/yaml.v2"
+)
+
+// Unmarshaller reads a YAML or JSON document (single object or list) and turns it into the
+// concrete Entity structs expected by the Perses API, based on the `kind` attribute of each object.
+type Unmarshaller struct {
+	isJSON  bool
+	objects []map[string]interface{}
+}
+
+func (u *Unmarshaller) Unmarshal(file string) ([]modelAPI.Entity, error) {
+	if err := u.read(file); err != nil {
+		return nil, err
+	}
+	return u.unmarshalEntities()
+}
+
+func (u *Unmarshaller) read(file string) error {
+	data, isJSON, err := readAndDetect(file)
+	if err != nil {
+		return err
+	}
+	u.isJSON = isJSON
+
+	var objects []map[string]interface{}
+	var object map[string]interface{}
+
+	if u.isJSON {
+		if jsonErr := json.Unmarshal(data, &objects); jsonErr != nil {
+			if jsonErr = json.Unmarshal(data, &object); jsonErr != nil {
+				return newReadFileErr(jsonErr)
+			}
+			objects = append(objects, object)
+		}
+	} else {
+		if yamlErr := yaml.Unmarshal(data, &objects); yamlErr != nil {
+			if yamlErr = yaml.Unmarshal(data, &object); yamlErr != nil {
+				return newReadFileErr(yamlErr)
+			}
+			objects = append(objects, object)
+		}
+	}
+	u.objects = objects
+	return nil
+}
+
+func (u *Unmarshaller) unmarshalEntities() ([]modelAPI.Entity, error) {
+	if len(u.objects) == 0 {
+		return nil, fmt.Errorf("unable to unmarshall data, data is empty")
+	}
+	var result []modelAPI.Entity
+	for i, object := range u.objects {
+		if _, ok := object["kind"]; !ok {
+			return nil, fmt.Errorf("objects[%d] unable to find 'kind' field", i)
+		}
+		kind := modelV1.Kind(fmt.Sprintf("%v", object["kind"]))
+		entity, err := modelV1.GetStruct(kind)
+		if err != nil {
+			logger.WithError(err).Debugf("unable to get the struct")
+			return nil, fmt.Errorf("resource %q not supported by the command", kind)
+		}
+
+		var data []byte
+		var marshalErr error
+		if u.isJSON {
+			data, marshalErr = json.Marshal(object)
+		} else {
+			data, marshalErr = yaml.Marshal(object)
+		}
+		if marshalErr != nil {
+			return nil, fmt.Errorf("cannot extract %s, marshalling error: %s", kind, marshalErr)
+		}
+
+		if unmarshalErr := u.unmarshalEntity(data, entity); unmarshalErr != nil {
+			return nil, fmt.Errorf("cannot extract %s, unmarshalling error: %s", kind, unmarshalErr)
+		}
+		result = append(result, entity)
+	}
+	return result, nil
+}
+
+func (u *Unmarshaller) unmarshalEntity(data []byte, entity modelAPI.Entity) error {
+	if u.isJSON {
+		return json.Unmarshal(data, entity)
+	}
+	return yaml.Unmarshal(data, entity)
+}
@@ -0,0 +1,33 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "fmt"
+
+const (
+	OnConflictSkip      = "skip"
+	OnConflictOverwrite = "overwrite"
+	OnConflictFail      = "fail"
+)
+
+// ValidateOnConflict ensures the value passed to --on-conflict, used by the `restore` command, is
+// one of the supported strategies.
+func ValidateOnConflict(onConflict string) error {
+	switch onConflict {
+	case OnConflictSkip, OnConflictOverwrite, OnConflictFail:
+		return nil
+	default:
+		return fmt.Errorf("--on-conflict must be %q, %q or %q", OnConflictSkip, OnConflictOverwrite, OnConflictFail)
+	}
+}
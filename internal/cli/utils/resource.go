@@ -0,0 +1,118 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	modelAPI "github.com/perses/perses/pkg/model/api"
+	modelV1 "github.com/perses/perses/pkg/model/api/v1"
+)
+
+type resource struct {
+	kind      modelV1.Kind
+	shortTerm string
+	aliases   []string
+}
+
+// resources is the list of alias per kind of resource supported by the CLI
+var resources = []resource{
+	{
+		kind:      modelV1.KindDashboard,
+		shortTerm: "dash",
+		aliases:   []string{"dashboards"},
+	},
+	{
+		kind:      modelV1.KindDatasource,
+		shortTerm: "dts",
+		aliases:   []string{"datasources"},
+	},
+	{
+		kind:    modelV1.KindProject,
+		aliases: []string{"projects"},
+	},
+}
+
+// AllKinds returns every resource kind known to the CLI, in a stable order. It's used by commands
+// like `dump`/`restore` that operate uniformly across every registered resource type.
+func AllKinds() []modelV1.Kind {
+	kinds := make([]modelV1.Kind, 0, len(resources))
+	for _, r := range resources {
+		kinds = append(kinds, r.kind)
+	}
+	return kinds
+}
+
+// IsGlobalResource returns true if the given resource type doesn't belong to a project.
+func IsGlobalResource(kind modelV1.Kind) bool {
+	return kind == modelV1.KindProject
+}
+
+func HandleSuccessResourceMessage(writer io.Writer, kind modelV1.Kind, project string, message string) error {
+	if IsGlobalResource(kind) {
+		return HandleString(writer, message)
+	}
+	return HandleString(writer, fmt.Sprintf("%s in the project %q", message, project))
+}
+
+// GetProject determinates the project to use for the current resource with the following logic:
+// if the value is defined in the metadata, then we use this one.
+// If it's not the case we consider the one given through the flag --project.
+func GetProject(metadata modelAPI.Metadata, defaultProject string) string {
+	project := defaultProject
+	if projectMetadata, ok := metadata.(*modelV1.ProjectMetadata); ok {
+		if len(projectMetadata.Project) > 0 {
+			project = projectMetadata.Project
+		}
+	}
+	return project
+}
+
+// GetKind tries to find the kind from the given string. It returns an error if the kind is not managed.
+func GetKind(res string) (modelV1.Kind, error) {
+	alias := reverseResourceAliases()[strings.ToLower(res)]
+	if len(alias) == 0 {
+		return "", fmt.Errorf("resource %q not managed", res)
+	}
+	return alias, nil
+}
+
+func reverseResourceAliases() map[string]modelV1.Kind {
+	result := make(map[string]modelV1.Kind)
+	for _, r := range resources {
+		for _, alias := range r.aliases {
+			result[strings.ToLower(alias)] = r.kind
+		}
+		result[strings.ToLower(string(r.kind))] = r.kind
+		if len(r.shortTerm) > 0 {
+			result[strings.ToLower(r.shortTerm)] = r.kind
+		}
+	}
+	return result
+}
+
+// FormatAvailableResourcesMessage formats the available resources that the user can use
+func FormatAvailableResourcesMessage() string {
+	var result []string
+	for _, r := range resources {
+		res := string(r.kind)
+		if len(r.shortTerm) > 0 {
+			res = fmt.Sprintf("%s (aka '%s')", r.kind, r.shortTerm)
+		}
+		result = append(result, res)
+	}
+	return FormatArrayMessage("you have to specify the resource type that you want to use. Valid resource types include:", result)
+}
@@ -15,23 +15,43 @@ package service
 
 import (
 	cmdUtils "github.com/perses/perses/internal/cli/utils"
-	"github.com/perses/perses/pkg/client/api"
+	v1 "github.com/perses/perses/pkg/client/api/v1"
+	dtsPlugin "github.com/perses/perses/pkg/datasource"
 	modelAPI "github.com/perses/perses/pkg/model/api"
 	modelV1 "github.com/perses/perses/pkg/model/api/v1"
 )
 
 type datasource struct {
 	Service
-	project   string
-	apiClient api.ClientInterface
+	apiClient v1.DatasourceInterface
 }
 
-func (d *datasource) ListResource(prefix string) (interface{}, error) {
-	return d.apiClient.V1().Datasource(d.project).List(prefix)
+func (d *datasource) CreateResource(entity modelAPI.Entity, dryRun bool) (modelAPI.Entity, error) {
+	return d.apiClient.Create(entity.(*modelV1.Datasource), dryRun)
+}
+
+func (d *datasource) UpdateResource(entity modelAPI.Entity, dryRun bool) (modelAPI.Entity, error) {
+	return d.apiClient.Update(entity.(*modelV1.Datasource), dryRun)
+}
+
+func (d *datasource) ListResource(prefix string) ([]modelAPI.Entity, error) {
+	list, err := d.apiClient.List(prefix)
+	if err != nil {
+		return nil, err
+	}
+	var result []modelAPI.Entity
+	for _, entity := range list {
+		result = append(result, entity)
+	}
+	return result, nil
 }
 
 func (d *datasource) GetResource(name string) (modelAPI.Entity, error) {
-	return d.apiClient.V1().Datasource(d.project).Get(name)
+	return d.apiClient.Get(name)
+}
+
+func (d *datasource) DeleteResource(name string) error {
+	return d.apiClient.Delete(name)
 }
 
 func (d *datasource) BuildMatrix(hits []modelAPI.Entity) [][]string {
@@ -41,19 +61,52 @@ func (d *datasource) BuildMatrix(hits []modelAPI.Entity) [][]string {
 		line := []string{
 			entity.Metadata.Name,
 			entity.Metadata.Project,
-			string(entity.Spec.GetKind()),
+			entity.Spec.GetKind(),
 			cmdUtils.FormatTime(entity.Metadata.UpdatedAt),
+			statusColumn(entity.Status),
+			lastCheckColumn(entity.Status),
 		}
+		line = append(line, dtsPlugin.ColumnValues(entity.Spec.GetKind(), entity.Spec.Config)...)
 		data = append(data, line)
 	}
 	return data
 }
 
 func (d *datasource) GetColumHeader() []string {
-	return []string{
+	header := []string{
 		"NAME",
 		"PROJECT",
 		"DATASOURCE_TYPE",
 		"AGE",
+		"STATUS",
+		"LAST_CHECK",
+	}
+	return append(header, dtsPlugin.ColumnHeaders()...)
+}
+
+// statusColumn and lastCheckColumn tolerate a nil Status, e.g. right after the datasource has been
+// created and the background health checker hasn't probed it yet.
+func statusColumn(status *modelV1.DatasourceStatus) string {
+	if status == nil {
+		return "unknown"
+	}
+	if status.Healthy {
+		return "healthy"
+	}
+	return "unhealthy"
+}
+
+func lastCheckColumn(status *modelV1.DatasourceStatus) string {
+	if status == nil || status.LastCheck.IsZero() {
+		return "-"
 	}
-}
\ No newline at end of file
+	return cmdUtils.FormatTime(status.LastCheck)
+}
+
+func (d *datasource) DumpAll() ([]modelAPI.Entity, error) {
+	return dumpAll(d)
+}
+
+func (d *datasource) RestoreAll(entities []modelAPI.Entity, onConflict string) ([]modelAPI.Entity, error) {
+	return restoreAll(d, entities, onConflict)
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	cmdUtils "github.com/perses/perses/internal/cli/utils"
+	"github.com/perses/perses/pkg/client/api"
+	"github.com/perses/perses/pkg/client/perseshttp"
+	modelAPI "github.com/perses/perses/pkg/model/api"
+	modelV1 "github.com/perses/perses/pkg/model/api/v1"
+)
+
+// Service is the abstraction the CLI commands rely on to manipulate a resource without having to
+// know its concrete kind. It is implemented once per kind of resource (Project, Dashboard, Datasource, ...)
+// so that commands like `create`, `apply` or `delete` work uniformly across all of them.
+type Service interface {
+	CreateResource(entity modelAPI.Entity, dryRun bool) (modelAPI.Entity, error)
+	UpdateResource(entity modelAPI.Entity, dryRun bool) (modelAPI.Entity, error)
+	ListResource(prefix string) ([]modelAPI.Entity, error)
+	GetResource(name string) (modelAPI.Entity, error)
+	DeleteResource(name string) error
+	BuildMatrix(hits []modelAPI.Entity) [][]string
+	GetColumHeader() []string
+	// DumpAll returns every resource of this kind, for the project the Service was built with. It's
+	// used by the `dump` command to back up a whole instance.
+	DumpAll() ([]modelAPI.Entity, error)
+	// RestoreAll creates or updates entities according to onConflict, and returns the ones actually
+	// written. It's used by the `restore` command to replay a `dump` archive.
+	RestoreAll(entities []modelAPI.Entity, onConflict string) ([]modelAPI.Entity, error)
+}
+
+// dumpAll is the DumpAll logic shared by every Service implementation: dumping a resource kind
+// boils down to listing every instance of it, regardless of the kind.
+func dumpAll(svc Service) ([]modelAPI.Entity, error) {
+	return svc.ListResource("")
+}
+
+// restoreAll is the RestoreAll logic shared by every Service implementation. For each entity, it
+// decides whether to create, update or skip it based on whether it already exists and onConflict.
+func restoreAll(svc Service, entities []modelAPI.Entity, onConflict string) ([]modelAPI.Entity, error) {
+	var restored []modelAPI.Entity
+	for _, entity := range entities {
+		name := entity.GetMetadata().GetName()
+		_, apiError := svc.GetResource(name)
+		if apiError != nil && !errors.Is(apiError, perseshttp.RequestNotFoundError) {
+			return restored, fmt.Errorf("unable to retrieve %q %q from the Perses API. %w", entity.GetKind(), name, apiError)
+		}
+		exists := apiError == nil
+
+		if exists {
+			switch onConflict {
+			case cmdUtils.OnConflictSkip:
+				continue
+			case cmdUtils.OnConflictFail:
+				return restored, fmt.Errorf("%q %q already exists", entity.GetKind(), name)
+			}
+			if _, err := svc.UpdateResource(entity, false); err != nil {
+				return restored, err
+			}
+		} else {
+			if _, err := svc.CreateResource(entity, false); err != nil {
+				return restored, err
+			}
+		}
+		restored = append(restored, entity)
+	}
+	return restored, nil
+}
+
+// NewService returns the Service implementation matching the given kind.
+func NewService(kind modelV1.Kind, projectName string, apiClient api.ClientInterface) (Service, error) {
+	switch kind {
+	case modelV1.KindDashboard:
+		return &dashboard{
+			apiClient: apiClient.V1().Dashboard(projectName),
+		}, nil
+	case modelV1.KindDatasource:
+		return &datasource{
+			apiClient: apiClient.V1().Datasource(projectName),
+		}, nil
+	case modelV1.KindProject:
+		return &project{
+			apiClient: apiClient.V1().Project(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("resource %q not supported by the command", kind)
+	}
+}
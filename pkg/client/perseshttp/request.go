@@ -0,0 +1,341 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perseshttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/perses/perses/pkg/httpsig"
+)
+
+const (
+	defaultAPIPrefix  = "/api"
+	defaultAPIVersion = "v1"
+)
+
+// Request allows for building up a request to a server in a chained fashion.
+// Any errors are stored until the end of your call, so you only have to check once.
+type Request struct {
+	client *http.Client
+	method string
+	token  string
+
+	// all component relative to the url
+	baseURL *url.URL
+	// API
+	apiPrefix  string // it's the api prefix such as /api
+	apiVersion string
+	// Resource
+	project  string
+	resource string
+	name     string
+	subpath  string
+
+	queryParam url.Values
+	dryRun     bool
+	body       io.Reader
+	bodyBytes  []byte
+	signer     *httpsig.Signer
+	err        error
+}
+
+// NewRequest creates a new request helper object for accessing resource on the API
+func NewRequest(client *http.Client, method string, baseURL *url.URL, token string) *Request {
+	return &Request{
+		client:     client,
+		method:     method,
+		token:      token,
+		baseURL:    baseURL,
+		apiPrefix:  defaultAPIPrefix,
+		apiVersion: defaultAPIVersion,
+	}
+}
+
+// Project set the project where the resource must be defined.
+func (r *Request) Project(project string) *Request {
+	r.project = project
+	return r
+}
+
+// Resource set the resource that the client want to access (like project, datasource ...etc.)
+func (r *Request) Resource(resource string) *Request {
+	r.resource = resource
+	return r
+}
+
+// Name set the name of the resource
+func (r *Request) Name(name string) *Request {
+	r.name = name
+	return r
+}
+
+// SubPath appends an additional path segment after the resource name, such as the proxy path of a datasource.
+func (r *Request) SubPath(subpath string) *Request {
+	r.subpath = subpath
+	return r
+}
+
+// Query set all queryParameter contains in the query passed as a parameter
+func (r *Request) Query(query QueryInterface) *Request {
+	if query == nil {
+		return r
+	}
+	if r.queryParam == nil {
+		r.queryParam = make(url.Values)
+	}
+	for k, v := range query.GetValues() {
+		r.queryParam[k] = append(r.queryParam[k], v...)
+	}
+	return r
+}
+
+// DryRun flags the request so the server only validates the change without persisting it.
+func (r *Request) DryRun(dryRun bool) *Request {
+	r.dryRun = dryRun
+	return r
+}
+
+// QueryInterface defines the query interface that you can set in the Request
+type QueryInterface interface {
+	GetValues() url.Values
+}
+
+// Body defines the body in the HTTP request. The body shall be json compatible
+func (r *Request) Body(obj interface{}) *Request {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		r.err = err
+	} else {
+		r.body = bytes.NewBuffer(data)
+		r.bodyBytes = data
+	}
+	return r
+}
+
+// Sign flags the request to be signed, using signer, with the HTTP Signatures scheme described in
+// pkg/httpsig. It's used for requests proxied to datasources/Perses instances that require
+// authenticated requests instead of (or in addition to) a bearer token.
+func (r *Request) Sign(signer *httpsig.Signer) *Request {
+	r.signer = signer
+	return r
+}
+
+// Do build the query and execute it.
+// The error and/or the response from the server are set in the object Response
+func (r *Request) Do() *Response {
+	if r.err != nil {
+		return &Response{err: r.err}
+	}
+
+	httpClient := r.client
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	httpRequest, err := r.prepareRequest()
+	if err != nil {
+		return &Response{err: err}
+	}
+
+	resp, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return &Response{err: err}
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	if resp.Body != nil {
+		data, readErr := ioutil.ReadAll(resp.Body)
+		return &Response{body: data, err: readErr, statusCode: resp.StatusCode}
+	}
+	return &Response{statusCode: resp.StatusCode}
+}
+
+// prepareRequest build the HTTP request that #Do function will execute
+func (r *Request) prepareRequest() (*http.Request, error) {
+	finalURL, err := r.url()
+	if err != nil {
+		return nil, err
+	}
+	httpRequest, err := http.NewRequest(r.method, finalURL, r.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.body != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+	httpRequest.Header.Set("Accept", "application/json")
+
+	if len(r.token) > 0 {
+		httpRequest.Header.Set("Authorization", fmt.Sprintf("Bearer %s", r.token))
+	}
+
+	if r.signer != nil {
+		httpRequest.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+		httpsig.SetDigest(httpRequest.Header, r.bodyBytes)
+		if err := r.signer.Sign(httpsig.NewSignableRequest(httpRequest)); err != nil {
+			return nil, fmt.Errorf("unable to sign request: %w", err)
+		}
+	}
+	return httpRequest, nil
+}
+
+// url build the final URL for the request, using the different pathParameter or queryParameter set
+func (r *Request) url() (string, error) {
+	path, err := r.buildPath()
+	if err != nil {
+		return "", err
+	}
+
+	finalURL := &url.URL{}
+	if r.baseURL != nil {
+		*finalURL = *r.baseURL
+	}
+	finalURL.Path = path
+
+	if r.dryRun {
+		if r.queryParam == nil {
+			r.queryParam = make(url.Values)
+		}
+		r.queryParam.Set("dryRun", "true")
+	}
+	if r.queryParam != nil {
+		finalURL.RawQuery = r.queryParam.Encode()
+	}
+	return finalURL.String(), nil
+}
+
+// buildPath builds the REST path according to a predefined ordering
+// /<api name>/<api version>[/projects/<project>]/<resource type>[/<resource name>[/<subpath>]]
+func (r *Request) buildPath() (string, error) {
+	var path strings.Builder
+
+	if len(r.apiPrefix) <= 0 {
+		return "", errors.New("api prefix cannot be empty")
+	}
+	path.WriteString(r.apiPrefix)
+
+	if len(r.apiVersion) > 0 {
+		path.WriteString(fmt.Sprintf("/%s", r.apiVersion))
+	}
+
+	if len(r.project) > 0 {
+		path.WriteString(fmt.Sprintf("/projects/%s", r.project))
+	}
+
+	if len(r.resource) <= 0 {
+		return "", errors.New("resource cannot be empty")
+	}
+	path.WriteString(fmt.Sprintf("/%s", r.resource))
+
+	if len(r.name) > 0 {
+		path.WriteString(fmt.Sprintf("/%s", r.name))
+	}
+
+	if len(r.subpath) > 0 {
+		path.WriteString(fmt.Sprintf("/%s", r.subpath))
+	}
+
+	return path.String(), nil
+}
+
+// RequestError is a format struct to defines the error the results of calling #Request.Do()
+type RequestError struct {
+	Message    string
+	StatusCode int
+	Err        error
+}
+
+func (re *RequestError) Error() string {
+	err := "something wrong happened with the request to the API."
+	if re.Err != nil {
+		err = fmt.Sprintf("%s Error: %s", err, re.Err.Error())
+	}
+	if len(re.Message) > 0 {
+		err = fmt.Sprintf("%s  Message: %s", err, re.Message)
+	}
+	if re.StatusCode > 0 {
+		err = fmt.Sprintf("%s StatusCode: %d", err, re.StatusCode)
+	}
+	return err
+}
+
+func (re *RequestError) Unwrap() error {
+	return re.Err
+}
+
+var (
+	RequestInternalError = &RequestError{Message: "internal server error", StatusCode: http.StatusInternalServerError}
+	RequestNotFoundError = &RequestError{Message: "document not found", StatusCode: http.StatusNotFound}
+)
+
+// Response contains the result of calling #Request.Do()
+type Response struct {
+	body       []byte
+	err        error
+	statusCode int
+}
+
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+// Error returns the error executing the request, nil if no error occurred.
+func (r *Response) Error() error {
+	e := &RequestError{Err: r.err}
+	if r.statusCode < http.StatusOK || r.statusCode > http.StatusPartialContent {
+		if r.statusCode == http.StatusInternalServerError {
+			return RequestInternalError
+		}
+		if r.statusCode == http.StatusNotFound {
+			return RequestNotFoundError
+		}
+		if r.body != nil {
+			response := &errorResponse{}
+			if unmarshalErr := json.Unmarshal(r.body, &response); unmarshalErr != nil {
+				e.Err = fmt.Errorf("something horrible occured when the client tried to decode the error message: %w", unmarshalErr)
+			} else {
+				e.Message = response.Message
+			}
+		}
+		e.StatusCode = r.statusCode
+	}
+
+	if e.Err != nil || e.StatusCode > 0 || len(e.Message) > 0 {
+		return e
+	}
+	return nil
+}
+
+// Object stores the result into respObj.
+func (r *Response) Object(respObj interface{}) error {
+	if err := r.Error(); err != nil {
+		return err
+	}
+	if r.body != nil {
+		if err := json.Unmarshal(r.body, respObj); err != nil {
+			return fmt.Errorf("unable to decode the response body. Error %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"fmt"
+	"time"
+
+	modelV1 "github.com/perses/perses/pkg/model/api/v1"
+)
+
+// HealthCheck runs the registered plugin's health check for kind against spec. It returns an error
+// when kind has no registered plugin, or the plugin doesn't support health checks at all -- as
+// opposed to the check itself failing, which is reported through HealthCheckResult.Healthy.
+func HealthCheck(kind string, spec map[string]interface{}) (HealthCheckResult, error) {
+	plugin, ok := Get(kind)
+	if !ok {
+		return HealthCheckResult{}, fmt.Errorf("no datasource plugin registered for kind %q", kind)
+	}
+	healthCheck := plugin.Capabilities().HealthCheck
+	if healthCheck == nil {
+		return HealthCheckResult{}, fmt.Errorf("datasource plugin %q does not support health checks", kind)
+	}
+	return healthCheck(spec), nil
+}
+
+// DatasourceLister lists every datasource the background Checker has to probe. It's implemented by
+// the server's storage layer.
+type DatasourceLister interface {
+	ListAll() ([]*modelV1.Datasource, error)
+}
+
+// StatusUpdater persists the outcome of a probe. It's implemented by the server's storage layer.
+type StatusUpdater interface {
+	UpdateStatus(project string, name string, status *modelV1.DatasourceStatus) error
+}
+
+// Checker periodically probes every datasource known to a DatasourceLister and records the outcome
+// through a StatusUpdater. The server mounts one alongside the datasource routes, so
+// `GET /api/v1/datasources` can serve an up-to-date Status.
+type Checker struct {
+	interval time.Duration
+	lister   DatasourceLister
+	updater  StatusUpdater
+}
+
+// NewChecker builds a Checker that probes every datasource returned by lister every interval, and
+// stores the outcome through updater.
+func NewChecker(interval time.Duration, lister DatasourceLister, updater StatusUpdater) *Checker {
+	return &Checker{interval: interval, lister: lister, updater: updater}
+}
+
+// Run blocks, probing every known datasource every c.interval, until stop is closed.
+func (c *Checker) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.runOnce()
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (c *Checker) runOnce() {
+	datasources, err := c.lister.ListAll()
+	if err != nil {
+		return
+	}
+	for _, ds := range datasources {
+		result, checkErr := HealthCheck(ds.Spec.GetKind(), ds.Spec.Config)
+		status := &modelV1.DatasourceStatus{LastCheck: time.Now().UTC()}
+		if checkErr != nil {
+			status.Message = checkErr.Error()
+		} else {
+			status.Healthy = result.Healthy
+			status.Message = result.Message
+			status.LatencyMs = result.Latency.Milliseconds()
+		}
+		_ = c.updater.UpdateStatus(ds.Metadata.Project, ds.Metadata.Name, status)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datasource defines the extension point used to plug a new datasource type (Prometheus, ...)
+// into both the API server (spec validation + request proxying) and the CLI (extra listing columns).
+package datasource
+
+import (
+	"net/http"
+	"time"
+)
+
+// HealthCheckResult is the outcome of a single probe of a datasource backend.
+type HealthCheckResult struct {
+	Healthy bool
+	Latency time.Duration
+	Message string
+}
+
+// Capabilities advertises what a Plugin supports on top of the base datasource behavior.
+type Capabilities struct {
+	// ExtraColumnHeaders are additional column titles the CLI should display when listing
+	// datasources of this kind, on top of the common NAME/PROJECT/DATASOURCE_TYPE/AGE/STATUS/LAST_CHECK
+	// columns.
+	ExtraColumnHeaders []string
+	// HealthCheck probes the datasource backend described by spec and reports whether it's
+	// reachable. It's nil for plugins that don't support health checking.
+	HealthCheck func(spec map[string]interface{}) HealthCheckResult
+}
+
+// Plugin is implemented once per supported datasource kind. The server uses it to validate the
+// spec supplied by the user and to proxy requests on behalf of a configured datasource; the CLI
+// uses it to enrich the `datasource` listing with kind-specific columns.
+type Plugin interface {
+	// Kind is the value expected in Datasource.spec.kind for this plugin to apply.
+	Kind() string
+	// ValidateSpec checks that spec holds everything this plugin needs (url, auth, ...).
+	ValidateSpec(spec map[string]interface{}) error
+	// Proxy returns an http.Handler able to forward the requests it recognizes to the datasource
+	// backend described by spec. keyID identifies the datasource for outbound HTTP Signatures
+	// (see pkg/httpsig); it's ignored by plugins that don't opt into signing requests. Any request
+	// this plugin doesn't recognize is delegated to next.
+	Proxy(keyID string, spec map[string]interface{}, next http.Handler) http.Handler
+	// Capabilities describes what this plugin brings on top of the base datasource behavior.
+	Capabilities() Capabilities
+	// ExtraColumnValues returns, in the same order as Capabilities().ExtraColumnHeaders, the values
+	// to display for spec when listing datasources through the CLI.
+	ExtraColumnValues(spec map[string]interface{}) []string
+}
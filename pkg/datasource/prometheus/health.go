@@ -0,0 +1,62 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/perses/perses/pkg/datasource"
+)
+
+const healthCheckTimeout = 5 * time.Second
+
+// healthCheck probes the Prometheus `/-/healthy` endpoint of the datasource described by spec.
+func healthCheck(spec map[string]interface{}) datasource.HealthCheckResult {
+	rawURL, _ := spec["url"].(string)
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return datasource.HealthCheckResult{Message: fmt.Sprintf("invalid url: %s", err)}
+	}
+	target.Path = path.Join(target.Path, "/-/healthy")
+
+	req, err := http.NewRequest(http.MethodGet, target.String(), nil)
+	if err != nil {
+		return datasource.HealthCheckResult{Message: err.Error()}
+	}
+	setAuthHeader(req, spec)
+
+	transport, err := newTransport(spec)
+	if err != nil {
+		return datasource.HealthCheckResult{Message: err.Error()}
+	}
+	client := &http.Client{Transport: transport, Timeout: healthCheckTimeout}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	latency := time.Since(start)
+	if err != nil {
+		return datasource.HealthCheckResult{Latency: latency, Message: err.Error()}
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	return datasource.HealthCheckResult{
+		Healthy: resp.StatusCode == http.StatusOK,
+		Latency: latency,
+		Message: fmt.Sprintf("received status code %d", resp.StatusCode),
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus is the first-class datasource.Plugin implementation, proxying the handful of
+// read-only Prometheus HTTP API endpoints a Perses dashboard needs.
+package prometheus
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/perses/perses/pkg/datasource"
+)
+
+// Kind is the value expected in Datasource.spec.kind to select this plugin.
+const Kind = "Prometheus"
+
+// allowedPathPrefixes is the set of Prometheus HTTP API endpoints a datasource is allowed to proxy.
+var allowedPathPrefixes = []string{
+	"/api/v1/query",
+	"/api/v1/query_range",
+	"/api/v1/labels",
+	"/api/v1/series",
+}
+
+func init() {
+	datasource.Register(&plugin{})
+}
+
+type plugin struct{}
+
+func (p *plugin) Kind() string {
+	return Kind
+}
+
+func (p *plugin) ValidateSpec(spec map[string]interface{}) error {
+	rawURL, ok := spec["url"].(string)
+	if !ok || len(rawURL) == 0 {
+		return fmt.Errorf("prometheus datasource: 'url' is required")
+	}
+	if _, err := url.Parse(rawURL); err != nil {
+		return fmt.Errorf("prometheus datasource: invalid 'url': %w", err)
+	}
+
+	if rawInterval, ok := spec["scrape_interval"]; ok {
+		interval, ok := rawInterval.(string)
+		if !ok {
+			return fmt.Errorf("prometheus datasource: 'scrape_interval' must be a duration string")
+		}
+		if _, err := time.ParseDuration(interval); err != nil {
+			return fmt.Errorf("prometheus datasource: invalid 'scrape_interval': %w", err)
+		}
+	}
+
+	return validateAuth(spec)
+}
+
+func (p *plugin) Capabilities() datasource.Capabilities {
+	return datasource.Capabilities{
+		ExtraColumnHeaders: []string{"URL"},
+		HealthCheck:        healthCheck,
+	}
+}
+
+func (p *plugin) ExtraColumnValues(spec map[string]interface{}) []string {
+	rawURL, _ := spec["url"].(string)
+	return []string{rawURL}
+}
+
+func (p *plugin) Proxy(keyID string, spec map[string]interface{}, next http.Handler) http.Handler {
+	rawURL, _ := spec["url"].(string)
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return next
+	}
+
+	signer, err := newSigner(keyID, spec)
+	if err != nil {
+		return next
+	}
+
+	reverseProxy := httputil.NewSingleHostReverseProxy(target)
+	reverseProxy.Transport, err = newTransport(spec)
+	if err != nil {
+		return next
+	}
+	director := reverseProxy.Director
+	reverseProxy.Director = func(req *http.Request) {
+		director(req)
+		setAuthHeader(req, spec)
+		if signer != nil {
+			signRequest(req, signer)
+		}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isAllowedPath(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		reverseProxy.ServeHTTP(w, r)
+	})
+}
+
+func isAllowedPath(path string) bool {
+	for _, prefix := range allowedPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
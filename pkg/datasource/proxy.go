@@ -0,0 +1,35 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/perses/perses/pkg/httpsig"
+)
+
+// NewProxy builds the http.Handler the API server mounts at
+// /api/v1/projects/{project}/datasources/{name}/proxy/... for a given datasource kind and spec.
+// It looks up the Plugin registered for kind and delegates to its Proxy method, deriving the keyID
+// the plugin should use to sign outbound requests (see pkg/httpsig.KeyID) from project and name.
+// It returns an error when kind has no registered Plugin. Requests the Plugin doesn't recognize
+// are delegated to next.
+func NewProxy(project string, name string, kind string, spec map[string]interface{}, next http.Handler) (http.Handler, error) {
+	plugin, ok := Get(kind)
+	if !ok {
+		return nil, fmt.Errorf("no datasource plugin registered for kind %q", kind)
+	}
+	return plugin.Proxy(httpsig.KeyID(project, name), spec, next), nil
+}
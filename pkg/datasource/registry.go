@@ -0,0 +1,102 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	registryMutex sync.RWMutex
+	registry      = map[string]Plugin{}
+)
+
+// Register makes a Plugin available under its own Kind(). It is meant to be called from the init()
+// function of the package implementing the plugin (see pkg/datasource/prometheus for an example).
+// It panics if a plugin is already registered for the same kind, as that is a programming error.
+func Register(plugin Plugin) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	kind := plugin.Kind()
+	if _, exists := registry[kind]; exists {
+		panic(fmt.Sprintf("a datasource plugin is already registered for kind %q", kind))
+	}
+	registry[kind] = plugin
+}
+
+// Get returns the Plugin registered for kind, if any.
+func Get(kind string) (Plugin, bool) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	plugin, ok := registry[kind]
+	return plugin, ok
+}
+
+// ColumnHeaders returns the deduplicated, deterministically ordered union of every registered
+// plugin's extra column headers. It is used by the CLI to build the `datasource` listing header.
+func ColumnHeaders() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	var headers []string
+	seen := make(map[string]bool)
+	for _, kind := range sortedKinds() {
+		for _, header := range registry[kind].Capabilities().ExtraColumnHeaders {
+			if !seen[header] {
+				seen[header] = true
+				headers = append(headers, header)
+			}
+		}
+	}
+	return headers
+}
+
+// ColumnValues returns the values to display for spec, aligned with ColumnHeaders(). Columns that
+// don't apply to kind are left empty so every row of the listing has the same width.
+func ColumnValues(kind string, spec map[string]interface{}) []string {
+	headers := ColumnHeaders()
+	values := make([]string, len(headers))
+
+	plugin, ok := Get(kind)
+	if !ok {
+		return values
+	}
+
+	pluginHeaders := plugin.Capabilities().ExtraColumnHeaders
+	pluginValues := plugin.ExtraColumnValues(spec)
+	for i, header := range pluginHeaders {
+		if i >= len(pluginValues) {
+			break
+		}
+		for j, globalHeader := range headers {
+			if globalHeader == header {
+				values[j] = pluginValues[i]
+			}
+		}
+	}
+	return values
+}
+
+// sortedKinds returns the registered kinds in a deterministic order. Callers must hold registryMutex.
+func sortedKinds() []string {
+	kinds := make([]string, 0, len(registry))
+	for kind := range registry {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpsig implements the signing and verification side of the HTTP Signatures draft
+// (https://datatracker.ietf.org/doc/html/draft-cavage-http-signatures), so Perses can authenticate
+// outbound requests to federated/remote datasources and verify inbound requests from other Perses
+// instances, without relying on a shared bearer token.
+package httpsig
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+)
+
+// Algorithm identifies the signing algorithm used to produce a Signature header.
+type Algorithm string
+
+const (
+	AlgorithmRSASHA256 Algorithm = "rsa-sha256"
+	AlgorithmEd25519   Algorithm = "ed25519"
+)
+
+// requestTargetHeader is the pseudo-header carrying the lower-cased method and path of the request,
+// as defined by the HTTP Signatures draft.
+const requestTargetHeader = "(request-target)"
+
+// defaultSignedHeaders is the ordered list of headers covered by the signature when the caller
+// doesn't provide its own.
+var defaultSignedHeaders = []string{requestTargetHeader, "host", "date", "digest"}
+
+// Signer signs requests on behalf of keyID using key, which must be a *rsa.PrivateKey (for
+// AlgorithmRSASHA256) or an ed25519.PrivateKey (for AlgorithmEd25519).
+type Signer struct {
+	KeyID     string
+	Algorithm Algorithm
+	Key       crypto.Signer
+}
+
+// Sign computes the signature over the headers already present on req (Host, Date, Digest) and
+// sets the resulting `Signature` header. The caller is responsible for setting the Date and Digest
+// headers beforehand, e.g. via SetDigest.
+func (s *Signer) Sign(req signableRequest) error {
+	signingString, err := buildSigningString(req, defaultSignedHeaders)
+	if err != nil {
+		return err
+	}
+
+	signature, err := s.sign([]byte(signingString))
+	if err != nil {
+		return fmt.Errorf("unable to sign request: %w", err)
+	}
+
+	req.Header().Set("Signature", fmt.Sprintf(
+		`keyId="%s",algorithm="%s",headers="%s",signature="%s"`,
+		s.KeyID, s.Algorithm, joinHeaders(defaultSignedHeaders), base64.StdEncoding.EncodeToString(signature),
+	))
+	return nil
+}
+
+func (s *Signer) sign(data []byte) ([]byte, error) {
+	switch s.Algorithm {
+	case AlgorithmRSASHA256:
+		privateKey, ok := s.Key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("algorithm %q requires an *rsa.PrivateKey", s.Algorithm)
+		}
+		digest := sha256.Sum256(data)
+		return rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+	case AlgorithmEd25519:
+		privateKey, ok := s.Key.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("algorithm %q requires an ed25519.PrivateKey", s.Algorithm)
+		}
+		return ed25519.Sign(privateKey, data), nil
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", s.Algorithm)
+	}
+}
+
+// SetDigest computes the SHA-256 digest of body and sets the `Digest` header, as expected by the
+// `digest` entry of the signing string. Call this before Sign.
+func SetDigest(header headerSetter, body []byte) {
+	sum := sha256.Sum256(body)
+	header.Set("Digest", fmt.Sprintf("SHA-256=%s", base64.StdEncoding.EncodeToString(sum[:])))
+}
+
+func joinHeaders(headers []string) string {
+	result := headers[0]
+	for _, header := range headers[1:] {
+		result += " " + header
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpsig
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// KeyStore resolves the public key that verifies requests signed under keyID. keyId is the
+// identifier carried in the `keyId` field of the Signature header, see KeyID.
+type KeyStore interface {
+	Get(keyID string) (crypto.PublicKey, error)
+}
+
+// KeyID derives the keyId used in the Signature header from the project and name of the
+// datasource the key belongs to, mirroring how resource IDs are generated elsewhere (see
+// v1.GenerateDatasourceID).
+func KeyID(project string, name string) string {
+	return fmt.Sprintf("%s/%s", project, name)
+}
+
+// FileKeyStore resolves a keyId to the PEM-encoded public key stored at <Dir>/<keyId>.pub.
+// Slashes in keyId (e.g. "myproject/my-datasource") are preserved as nested directories.
+type FileKeyStore struct {
+	Dir string
+}
+
+func (s *FileKeyStore) Get(keyID string) (crypto.PublicKey, error) {
+	path := filepath.Join(s.Dir, fmt.Sprintf("%s.pub", keyID))
+	data, err := ioutil.ReadFile(path) // nolint: gosec
+	if err != nil {
+		return nil, fmt.Errorf("unable to read public key for keyId %q: %w", keyID, err)
+	}
+	return parsePublicKey(data)
+}
+
+// EnvKeyStore resolves a keyId to a base64-encoded, PEM-wrapped public key stored in the
+// environment variable named Prefix + the keyId uppercased with every non alphanumeric
+// character replaced by '_'. It's meant for deployments (e.g. Kubernetes Secrets mounted as env
+// vars) where writing key files to disk isn't practical.
+type EnvKeyStore struct {
+	Prefix string
+}
+
+func (s *EnvKeyStore) Get(keyID string) (crypto.PublicKey, error) {
+	envVar := s.Prefix + sanitizeEnvName(keyID)
+	rawValue, ok := os.LookupEnv(envVar)
+	if !ok {
+		return nil, fmt.Errorf("no public key found for keyId %q (environment variable %q is not set)", keyID, envVar)
+	}
+	data, err := base64.StdEncoding.DecodeString(rawValue)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode public key for keyId %q: %w", keyID, err)
+	}
+	return parsePublicKey(data)
+}
+
+func sanitizeEnvName(keyID string) string {
+	result := []byte(keyID)
+	for i, c := range result {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			result[i] = '_'
+		} else if c >= 'a' && c <= 'z' {
+			result[i] = c - 'a' + 'A'
+		}
+	}
+	return string(result)
+}
+
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("unable to decode PEM block")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpsig
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// headerSetter is the minimal surface SetDigest needs; http.Header and http.Request.Header both
+// satisfy it.
+type headerSetter interface {
+	Set(key, value string)
+}
+
+// signableRequest is the minimal surface Sign/verify need from an *http.Request, so this package
+// doesn't have to depend on which side (client or server) constructed it.
+type signableRequest interface {
+	Header() http.Header
+	Method() string
+	RequestPath() string
+	Host() string
+}
+
+// requestAdapter wraps an *http.Request so it satisfies signableRequest.
+type requestAdapter struct {
+	*http.Request
+}
+
+// NewSignableRequest adapts req so it can be passed to Signer.Sign. Verify takes the *http.Request
+// itself and adapts it internally.
+func NewSignableRequest(req *http.Request) signableRequest {
+	return requestAdapter{req}
+}
+
+func (r requestAdapter) Header() http.Header { return r.Request.Header }
+func (r requestAdapter) Method() string      { return r.Request.Method }
+func (r requestAdapter) RequestPath() string { return r.Request.URL.RequestURI() }
+// Host returns the request's Host field, falling back to the URL's host when Host is empty.
+// Incoming server requests only populate Host; http.NewRequest sets Host from URL.Host.
+func (r requestAdapter) Host() string {
+	if len(r.Request.Host) > 0 {
+		return r.Request.Host
+	}
+	return r.Request.URL.Host
+}
+
+// buildSigningString constructs the string to sign/verify, one "name: value" line per header, in
+// the order given by headers, as defined by the HTTP Signatures draft.
+func buildSigningString(req signableRequest, headers []string) (string, error) {
+	lines := make([]string, 0, len(headers))
+	for _, header := range headers {
+		var value string
+		switch header {
+		case requestTargetHeader:
+			value = fmt.Sprintf("%s %s", strings.ToLower(req.Method()), req.RequestPath())
+		case "host":
+			value = req.Host()
+			if len(value) == 0 {
+				return "", fmt.Errorf("cannot build signing string: %q header is not set", header)
+			}
+		default:
+			value = req.Header().Get(header)
+			if len(value) == 0 {
+				return "", fmt.Errorf("cannot build signing string: %q header is not set", header)
+			}
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", header, value))
+	}
+	return strings.Join(lines, "\n"), nil
+}
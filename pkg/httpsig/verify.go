@@ -0,0 +1,190 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpsig
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+var signatureFieldRegexp = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// maxClockSkew is how far the signed `Date` header may drift from the verifier's clock before a
+// request is rejected as stale. It also bounds how long a captured Signature stays replayable.
+const maxClockSkew = 5 * time.Minute
+
+// Verify checks the `Signature` header of req against the public key resolved from store using the
+// header's keyId. It also requires the signature to cover `date` and `digest`, rejects a `Date`
+// outside maxClockSkew, and checks the request body against the `Digest` header -- otherwise an
+// attacker holding one captured request could replay it indefinitely with a swapped body. It
+// returns an error describing why verification failed, or nil if req is authentic.
+func Verify(req *http.Request, store KeyStore) error {
+	fields, err := parseSignatureHeader(req.Header.Get("Signature"))
+	if err != nil {
+		return err
+	}
+
+	publicKey, err := store.Get(fields["keyId"])
+	if err != nil {
+		return fmt.Errorf("unable to verify signature: %w", err)
+	}
+
+	headers := splitHeaders(fields["headers"])
+	if err := verifyDate(req.Header.Get("Date"), headers); err != nil {
+		return err
+	}
+	if err := verifyDigest(req, headers); err != nil {
+		return err
+	}
+
+	signingString, err := buildSigningString(NewSignableRequest(req), headers)
+	if err != nil {
+		return err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(fields["signature"])
+	if err != nil {
+		return fmt.Errorf("unable to decode signature: %w", err)
+	}
+
+	return verifySignature(Algorithm(fields["algorithm"]), publicKey, []byte(signingString), signature)
+}
+
+// verifyDate ensures the signature covers the `date` header and that its value is within
+// maxClockSkew of now, rejecting both stale and implausibly-future requests.
+func verifyDate(dateHeader string, coveredHeaders []string) error {
+	if !containsHeader(coveredHeaders, "date") {
+		return fmt.Errorf("signature must cover the %q header", "date")
+	}
+	date, err := http.ParseTime(dateHeader)
+	if err != nil {
+		return fmt.Errorf("unable to parse the Date header: %w", err)
+	}
+	if skew := time.Since(date); skew > maxClockSkew || skew < -maxClockSkew {
+		return fmt.Errorf("request Date is %s away from the current time, rejecting as stale", skew)
+	}
+	return nil
+}
+
+// verifyDigest ensures the signature covers the `digest` header and that it matches the SHA-256
+// digest of the actual request body. req.Body is consumed in the process, so it's replaced with an
+// equivalent reader before returning, so that next still sees the full body.
+func verifyDigest(req *http.Request, coveredHeaders []string) error {
+	if !containsHeader(coveredHeaders, "digest") {
+		return fmt.Errorf("signature must cover the %q header", "digest")
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		if err != nil {
+			return fmt.Errorf("unable to read the request body: %w", err)
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	sum := sha256.Sum256(body)
+	expectedDigest := fmt.Sprintf("SHA-256=%s", base64.StdEncoding.EncodeToString(sum[:]))
+	if req.Header.Get("Digest") != expectedDigest {
+		return fmt.Errorf("request body does not match the Digest header")
+	}
+	return nil
+}
+
+func containsHeader(headers []string, name string) bool {
+	for _, header := range headers {
+		if header == name {
+			return true
+		}
+	}
+	return false
+}
+
+func verifySignature(algorithm Algorithm, publicKey crypto.PublicKey, signingString []byte, signature []byte) error {
+	switch algorithm {
+	case AlgorithmRSASHA256:
+		rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm %q requires an *rsa.PublicKey", algorithm)
+		}
+		digest := sha256.Sum256(signingString)
+		if err := rsa.VerifyPKCS1v15(rsaPublicKey, crypto.SHA256, digest[:], signature); err != nil {
+			return fmt.Errorf("signature verification failed: %w", err)
+		}
+		return nil
+	case AlgorithmEd25519:
+		ed25519PublicKey, ok := publicKey.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("algorithm %q requires an ed25519.PublicKey", algorithm)
+		}
+		if !ed25519.Verify(ed25519PublicKey, signingString, signature) {
+			return fmt.Errorf("signature verification failed")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm %q", algorithm)
+	}
+}
+
+func parseSignatureHeader(header string) (map[string]string, error) {
+	if len(header) == 0 {
+		return nil, fmt.Errorf("request is missing the Signature header")
+	}
+	fields := map[string]string{}
+	for _, match := range signatureFieldRegexp.FindAllStringSubmatch(header, -1) {
+		fields[match[1]] = match[2]
+	}
+	for _, required := range []string{"keyId", "algorithm", "headers", "signature"} {
+		if _, ok := fields[required]; !ok {
+			return nil, fmt.Errorf("Signature header is missing the %q field", required)
+		}
+	}
+	return fields, nil
+}
+
+func splitHeaders(headers string) []string {
+	var result []string
+	start := 0
+	for i, c := range headers {
+		if c == ' ' {
+			result = append(result, headers[start:i])
+			start = i + 1
+		}
+	}
+	return append(result, headers[start:])
+}
+
+// Middleware returns an http.Handler that verifies the Signature header (including the request
+// body, via Verify) of every incoming request against store before delegating to next. Mount it in
+// front of the server's federation/webhook routes, i.e. the ones meant to be called by other Perses
+// instances rather than by percli.
+func Middleware(store KeyStore, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := Verify(r, store); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
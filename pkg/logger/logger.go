@@ -0,0 +1,107 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logger is the structured logging façade shared by the API server and the CLI. It wraps
+// go.uber.org/zap so the rest of the codebase doesn't depend on a particular logging library, and
+// exposes the --log-format/--log-level switches as a single Init call.
+package logger
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Logger is the subset of *zap.SugaredLogger that WithError returns, so callers don't have to
+// import zap themselves.
+type Logger interface {
+	Debug(args ...interface{})
+	Debugf(template string, args ...interface{})
+	Info(args ...interface{})
+	Infof(template string, args ...interface{})
+	Warn(args ...interface{})
+	Warnf(template string, args ...interface{})
+	Error(args ...interface{})
+	Errorf(template string, args ...interface{})
+}
+
+var std = mustBuild(FormatText, "info")
+
+// ValidateFormat ensures the value passed to --log-format is one of the supported formats.
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatJSON, FormatText:
+		return nil
+	default:
+		return fmt.Errorf("--log-format must be %q or %q", FormatJSON, FormatText)
+	}
+}
+
+// Init (re)configures the package-level logger used by Debug/Info/Warn/Error/WithError. format is
+// FormatJSON or FormatText, level is any level understood by zapcore.ParseLevel (debug, info,
+// warn, error, ...).
+func Init(format string, level string) error {
+	if err := ValidateFormat(format); err != nil {
+		return err
+	}
+	parsedLevel, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return fmt.Errorf("--log-level is invalid: %w", err)
+	}
+	std = build(format, parsedLevel)
+	return nil
+}
+
+func build(format string, level zapcore.Level) *zap.SugaredLogger {
+	cfg := zap.NewProductionConfig()
+	if format == FormatText {
+		cfg = zap.NewDevelopmentConfig()
+	}
+	cfg.Level = zap.NewAtomicLevelAt(level)
+	zapLogger, err := cfg.Build()
+	if err != nil {
+		// cfg is built from known-good defaults above, so Build() failing here would be a
+		// programming error rather than something callers can recover from.
+		panic(fmt.Sprintf("unable to build the logger: %s", err))
+	}
+	return zapLogger.Sugar()
+}
+
+func mustBuild(format string, level string) *zap.SugaredLogger {
+	parsedLevel, err := zapcore.ParseLevel(level)
+	if err != nil {
+		panic(err)
+	}
+	return build(format, parsedLevel)
+}
+
+// WithError returns a Logger that will include err on every subsequent log line, mirroring
+// logrus.WithError.
+func WithError(err error) Logger {
+	return std.With("error", err)
+}
+
+func Debug(args ...interface{})                   { std.Debug(args...) }
+func Debugf(template string, args ...interface{}) { std.Debugf(template, args...) }
+func Info(args ...interface{})                    { std.Info(args...) }
+func Infof(template string, args ...interface{})  { std.Infof(template, args...) }
+func Warn(args ...interface{})                    { std.Warn(args...) }
+func Warnf(template string, args ...interface{})  { std.Warnf(template, args...) }
+func Error(args ...interface{})                   { std.Error(args...) }
+func Errorf(template string, args ...interface{}) { std.Errorf(template, args...) }
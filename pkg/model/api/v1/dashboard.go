@@ -0,0 +1,45 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	modelAPI "github.com/perses/perses/pkg/model/api"
+)
+
+func GenerateDashboardID(project string, name string) string {
+	return generateProjectResourceID("dashboards", project, name)
+}
+
+// DashboardSpec is kept as a raw document for now: panels/layouts/variables are out of scope
+// of the CLI CRUD work and are expected to grow into their own sub-package as the model matures.
+type DashboardSpec map[string]interface{}
+
+// Dashboard is the resource holding the definition of a Perses dashboard.
+type Dashboard struct {
+	Kind     Kind            `json:"kind" yaml:"kind"`
+	Metadata ProjectMetadata `json:"metadata" yaml:"metadata"`
+	Spec     DashboardSpec   `json:"spec" yaml:"spec"`
+}
+
+func (d *Dashboard) GenerateID() string {
+	return GenerateDashboardID(d.Metadata.Project, d.Metadata.Name)
+}
+
+func (d *Dashboard) GetMetadata() modelAPI.Metadata {
+	return &d.Metadata
+}
+
+func (d *Dashboard) GetKind() string {
+	return string(d.Kind)
+}
@@ -0,0 +1,65 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"time"
+
+	modelAPI "github.com/perses/perses/pkg/model/api"
+)
+
+func GenerateDatasourceID(project string, name string) string {
+	return generateProjectResourceID("datasources", project, name)
+}
+
+// DatasourceSpec holds the configuration of one datasource. Kind identifies which plugin
+// (prometheus, ...) has to be used to interpret Config.
+type DatasourceSpec struct {
+	Kind   string                 `json:"kind" yaml:"kind"`
+	Config map[string]interface{} `json:"config" yaml:"config"`
+}
+
+func (d *DatasourceSpec) GetKind() string {
+	return d.Kind
+}
+
+// DatasourceStatus holds the result of the last health check run against a datasource, as
+// maintained by the server's background health checker (see pkg/datasource.Checker).
+type DatasourceStatus struct {
+	Healthy   bool      `json:"healthy" yaml:"healthy"`
+	Message   string    `json:"message,omitempty" yaml:"message,omitempty"`
+	LatencyMs int64     `json:"latency_ms,omitempty" yaml:"latency_ms,omitempty"`
+	LastCheck time.Time `json:"last_check,omitempty" yaml:"last_check,omitempty"`
+}
+
+// Datasource is the datasource you can define in your project.
+// It is not shared across projects: a Dashboard can use it only if it belongs to the same project.
+type Datasource struct {
+	Kind     Kind              `json:"kind" yaml:"kind"`
+	Metadata ProjectMetadata   `json:"metadata" yaml:"metadata"`
+	Spec     DatasourceSpec    `json:"spec" yaml:"spec"`
+	Status   *DatasourceStatus `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+func (d *Datasource) GenerateID() string {
+	return GenerateDatasourceID(d.Metadata.Project, d.Metadata.Name)
+}
+
+func (d *Datasource) GetMetadata() modelAPI.Metadata {
+	return &d.Metadata
+}
+
+func (d *Datasource) GetKind() string {
+	return string(d.Kind)
+}
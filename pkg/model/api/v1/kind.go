@@ -0,0 +1,52 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+
+	modelAPI "github.com/perses/perses/pkg/model/api"
+)
+
+type Kind string
+
+const (
+	KindDashboard  Kind = "Dashboard"
+	KindDatasource Kind = "Datasource"
+	KindProject    Kind = "Project"
+)
+
+var kindMap = map[Kind]bool{
+	KindDashboard:  true,
+	KindDatasource: true,
+	KindProject:    true,
+}
+
+func (k Kind) IsValid() bool {
+	return kindMap[k]
+}
+
+// GetStruct return a pointer to an empty struct that matches the kind passed as a parameter.
+func GetStruct(kind Kind) (modelAPI.Entity, error) {
+	switch kind {
+	case KindDashboard:
+		return &Dashboard{}, nil
+	case KindDatasource:
+		return &Datasource{}, nil
+	case KindProject:
+		return &Project{}, nil
+	default:
+		return nil, fmt.Errorf("%q has no associated struct", kind)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 The Perses Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"fmt"
+
+	modelAPI "github.com/perses/perses/pkg/model/api"
+)
+
+func GenerateProjectID(name string) string {
+	return fmt.Sprintf("/projects/%s", name)
+}
+
+// Project is the resource used to group Dashboards and Datasources together.
+type Project struct {
+	Kind     Kind     `json:"kind" yaml:"kind"`
+	Metadata Metadata `json:"metadata" yaml:"metadata"`
+}
+
+func (p *Project) GenerateID() string {
+	return GenerateProjectID(p.Metadata.Name)
+}
+
+func (p *Project) GetMetadata() modelAPI.Metadata {
+	return &p.Metadata
+}
+
+func (p *Project) GetKind() string {
+	return string(p.Kind)
+}